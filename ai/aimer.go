@@ -0,0 +1,50 @@
+// Package ai provides pluggable aiming policies for a CPU-controlled tank.
+// An Aimer only ever sees a State snapshot and returns the angle and power
+// it wants to fire with; it never touches the real game model, so trying
+// out candidate shots can't corrupt the match in progress.
+package ai
+
+import "github.com/Piyushjha03/terminalTanks/pkg/tanks"
+
+// maxFlightSteps bounds a trial shot's simulation so a shot that somehow
+// never lands (e.g. a huge upward power with no drag) can't hang an Aim call.
+const maxFlightSteps = 2000
+
+// State is everything an Aimer is allowed to see: the terrain's per-column
+// surface height, where the tank and target sit, and the round's physics
+// constants.
+type State struct {
+	Terrain   []int
+	TankPos   int
+	TargetPos int
+	Wind      float64
+	Drag      float64
+	Gravity   float64
+}
+
+// Aimer picks an angle and power to fire at, given the current match state.
+type Aimer interface {
+	Aim(state State) (angle, power float64)
+}
+
+// simulateShot runs a trial shot through the same headless tanks.Game the
+// real round plays on, built fresh from a clone of the terrain heights, so
+// it can never mutate the live match in progress.
+func simulateShot(s State, angle, power float64) (impactX, impactY int, hit bool) {
+	g := &tanks.Game{
+		Terrain:   tanks.NewTerrainGrid(s.Terrain, tanks.TerrainRows),
+		TankPos:   s.TankPos,
+		TargetPos: s.TargetPos,
+		Wind:      s.Wind,
+		Drag:      s.Drag,
+		Gravity:   s.Gravity,
+		Shell:     tanks.ShellStandard,
+	}
+	g.Fire(angle, power)
+
+	for i := 0; i < maxFlightSteps && g.Simulating; i++ {
+		g.Step(tanks.DefaultDt)
+	}
+
+	return g.BallPosX, g.BallPosY, g.Hit
+}
@@ -0,0 +1,38 @@
+package ai
+
+// BinarySearchAimer keeps a fixed launch angle and brackets the power
+// needed to reach the target by simulating trial shots, narrowing the
+// bracket toward whichever half undershot or overshot.
+type BinarySearchAimer struct {
+	Angle      float64
+	MinPower   float64
+	MaxPower   float64
+	Iterations int
+}
+
+// NewBinarySearchAimer returns a BinarySearchAimer with sensible defaults
+// for this game's terrain scale.
+func NewBinarySearchAimer() *BinarySearchAimer {
+	return &BinarySearchAimer{Angle: 45, MinPower: 5, MaxPower: 100, Iterations: 12}
+}
+
+// Aim implements Aimer.
+func (b *BinarySearchAimer) Aim(s State) (angle, power float64) {
+	lo, hi := b.MinPower, b.MaxPower
+	power = (lo + hi) / 2
+
+	for i := 0; i < b.Iterations; i++ {
+		impactX, _, hit := simulateShot(s, b.Angle, power)
+		if hit {
+			break
+		}
+		if impactX < s.TargetPos {
+			lo = power
+		} else {
+			hi = power
+		}
+		power = (lo + hi) / 2
+	}
+
+	return b.Angle, power
+}
@@ -0,0 +1,77 @@
+package ai
+
+import "math"
+
+// minDelta is the step size below which GradientAimer gives up refining and
+// fires with its current best guess. startingDelta is the step size each
+// Aim call searches from, wide enough to cover a fresh round's whole
+// plausible angle/power range.
+const (
+	minDelta      = 0.05
+	startingDelta = 5
+)
+
+// GradientAimer keeps the angle and power it last fired with as a warm
+// start, then samples the four neighbors around it and steps toward
+// whichever trial landed closest to the target, halving its step size
+// whenever no neighbor improves on the current best (simulated-annealing
+// style). This is the gradient-following strategy ant/slime agents use to
+// climb a pheromone field, applied to artillery aiming instead.
+type GradientAimer struct {
+	angle, power   float64
+	deltaA, deltaP float64
+}
+
+// NewGradientAimer returns a GradientAimer with a neutral starting guess.
+func NewGradientAimer() *GradientAimer {
+	return &GradientAimer{angle: 45, power: 20, deltaA: startingDelta, deltaP: startingDelta}
+}
+
+// Aim implements Aimer. Critically, every candidate it tries is scored with
+// simulateShot against a clone of the terrain, so nothing here ever touches
+// the real match in progress.
+func (g *GradientAimer) Aim(s State) (angle, power float64) {
+	// Reset the search step each call: without this, a round that
+	// converges all the way down to minDelta leaves the loop below
+	// permanently false, so every later call (a new round, in the
+	// training harness) would return this stale warm start with no
+	// search at all.
+	g.deltaA, g.deltaP = startingDelta, startingDelta
+
+	bestMiss := g.missOf(s, g.angle, g.power)
+
+	for g.deltaA > minDelta || g.deltaP > minDelta {
+		type candidate struct{ angle, power, miss float64 }
+		candidates := [4]candidate{
+			{g.angle + g.deltaA, g.power, g.missOf(s, g.angle+g.deltaA, g.power)},
+			{g.angle - g.deltaA, g.power, g.missOf(s, g.angle-g.deltaA, g.power)},
+			{g.angle, g.power + g.deltaP, g.missOf(s, g.angle, g.power+g.deltaP)},
+			{g.angle, g.power - g.deltaP, g.missOf(s, g.angle, g.power-g.deltaP)},
+		}
+
+		best := candidate{g.angle, g.power, bestMiss}
+		for _, c := range candidates {
+			if c.miss < best.miss {
+				best = c
+			}
+		}
+
+		if best.miss >= bestMiss {
+			g.deltaA /= 2
+			g.deltaP /= 2
+			continue
+		}
+
+		g.angle, g.power, bestMiss = best.angle, best.power, best.miss
+		if bestMiss == 0 {
+			break
+		}
+	}
+
+	return g.angle, g.power
+}
+
+func (g *GradientAimer) missOf(s State, angle, power float64) float64 {
+	impactX, _, _ := simulateShot(s, angle, power)
+	return math.Abs(float64(impactX - s.TargetPos))
+}
@@ -0,0 +1,75 @@
+package ai
+
+import (
+	"github.com/Piyushjha03/terminalTanks/internal/rng"
+	"github.com/Piyushjha03/terminalTanks/pkg/tanks"
+)
+
+// maxShotsPerRound bounds how many attempts the harness gives an aimer at a
+// single round before counting it as unsolved, so a broken Aimer can't hang
+// training.
+const maxShotsPerRound = 50
+
+// Result reports a training run's aggregate performance so different Aimer
+// implementations can be compared.
+type Result struct {
+	Rounds         int
+	AvgShotsToHit  float64
+	RoundsUnsolved int
+}
+
+// RunTrainingHarness plays rounds rounds against aimer on terrain generated
+// from seed, retrying the same round until the aimer hits or gives up, and
+// reports the average number of shots it took to hit. An unsolved round
+// counts toward that average as maxShotsPerRound shots.
+func RunTrainingHarness(aimer Aimer, rounds int, seed uint64) Result {
+	source := rng.New(seed)
+
+	var totalShots int
+	var unsolved int
+
+	for i := 0; i < rounds; i++ {
+		state := randomState(source)
+
+		shots := 0
+		solved := false
+		for shots < maxShotsPerRound {
+			shots++
+			angle, power := aimer.Aim(state)
+			if _, _, hit := simulateShot(state, angle, power); hit {
+				solved = true
+				break
+			}
+		}
+		if !solved {
+			unsolved++
+		}
+		totalShots += shots
+	}
+
+	return Result{
+		Rounds:         rounds,
+		AvgShotsToHit:  float64(totalShots) / float64(rounds),
+		RoundsUnsolved: unsolved,
+	}
+}
+
+// randomState builds a training round's terrain, tank, target, and wind
+// from source. It deliberately keeps terrain generation simple (flat random
+// heights, no interpolation) since the harness only needs plausible
+// trajectories to compare aimers, not a realistic-looking map.
+func randomState(source *rng.Source) State {
+	heights := make([]int, tanks.TerrainWidth)
+	for i := range heights {
+		heights[i] = source.Intn(10) + 5
+	}
+
+	return State{
+		Terrain:   heights,
+		TankPos:   source.Intn(5) + 1,
+		TargetPos: source.Intn(5) + 75,
+		Wind:      (source.Float64()*2 - 1) * 3,
+		Drag:      tanks.DefaultDrag,
+		Gravity:   tanks.DefaultGravity,
+	}
+}
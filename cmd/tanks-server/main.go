@@ -0,0 +1,219 @@
+// Command tanks-server hosts terminalTanks matches over SSH so players can
+// join a lobby with a plain `ssh host -p port` and play turn-based artillery
+// together, the way netris and sshtron host their terminal games. Every
+// connecting session gets its own rendered view, driven by frames the
+// server broadcasts whenever a shot lands, so every player and spectator
+// always sees the exact same terrain and trajectory.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	bm "github.com/charmbracelet/wish/bubbletea"
+	"github.com/muesli/termenv"
+
+	"github.com/Piyushjha03/terminalTanks/internal/rng"
+	tanksnet "github.com/Piyushjha03/terminalTanks/net"
+	"github.com/Piyushjha03/terminalTanks/pkg/tanks"
+)
+
+const defaultAddr = ":2222"
+
+func main() {
+	addr := defaultAddr
+	if len(os.Args) > 1 {
+		addr = os.Args[1]
+	}
+
+	rooms := newRoomSet()
+
+	srv, err := wish.NewServer(
+		wish.WithAddress(addr),
+		wish.WithMiddleware(bm.MiddlewareWithProgramHandler(rootHandler(rooms), termenv.Ascii)),
+	)
+	if err != nil {
+		log.Fatalf("tanks-server: %v", err)
+	}
+
+	fmt.Printf("tanks-server listening on %s (ssh host -p %s to join the \"default\" room, or ssh -l <room> host -p %s for another)\n", addr, portOf(addr), portOf(addr))
+	if err := srv.ListenAndServe(); err != nil {
+		log.Fatalf("tanks-server: %v", err)
+	}
+}
+
+// portOf strips the leading colon wish.WithAddress expects, just for the
+// startup banner.
+func portOf(addr string) string {
+	if len(addr) > 0 && addr[0] == ':' {
+		return addr[1:]
+	}
+	return addr
+}
+
+// roomSet hands out the named match a connecting session asked to join,
+// creating it the first time anyone asks for that name. The SSH username
+// is what lets a player pick a room at connect time (`ssh -l arena host`),
+// the way the request asked for selectable match rooms.
+type roomSet struct {
+	mu      sync.Mutex
+	matches map[string]*match
+}
+
+func newRoomSet() *roomSet {
+	return &roomSet{matches: map[string]*match{}}
+}
+
+func (r *roomSet) get(name string) *match {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.matches[name]
+	if !ok {
+		m = newMatch(name)
+		r.matches[name] = m
+	}
+	return m
+}
+
+// rootHandler seats each connecting session into its requested room and
+// hands back the tea.Program that will render that player's (or
+// spectator's) view, registering it with the match so it receives every
+// future broadcast frame.
+func rootHandler(rooms *roomSet) bm.ProgramHandler {
+	return func(s ssh.Session) *tea.Program {
+		room := s.User()
+		if room == "" {
+			room = "default"
+		}
+		m := rooms.get(room)
+
+		m.mu.Lock()
+		id, err := m.lobby.Join(m.nextStartPosLocked())
+		if err != nil {
+			m.lobby.Spectate()
+			id = -1
+		}
+		m.mu.Unlock()
+
+		program := tea.NewProgram(newMatchModel(m, id), append(bm.MakeOptions(s), tea.WithAltScreen())...)
+		m.subscribe(program)
+		return program
+	}
+}
+
+// match is one named lobby's live game: the shared headless simulation,
+// the turn order, and every connected session's tea.Program so a shot
+// fired by one player can be broadcast to everyone else.
+type match struct {
+	name  string
+	lobby *tanksnet.Lobby
+
+	mu   sync.Mutex
+	game *tanks.Game
+	seq  int
+	subs []*tea.Program
+}
+
+func newMatch(name string) *match {
+	return &match{
+		name:  name,
+		lobby: tanksnet.NewLobby(name),
+		game:  tanks.NewGame(rng.New(uint64(time.Now().UnixNano()))),
+	}
+}
+
+// nextStartPosLocked spreads seats evenly across the terrain so up to
+// MaxPlayers tanks never start on top of each other. Callers must hold m.mu.
+func (m *match) nextStartPosLocked() int {
+	spacing := tanks.TerrainWidth / (tanksnet.MaxPlayers + 1)
+	return spacing * (len(m.lobby.Players) + 1)
+}
+
+// subscribe registers p to receive every frame broadcast from now on, and
+// immediately sends it the match's current state so a session joining
+// mid-match isn't stuck looking at a blank screen.
+func (m *match) subscribe(p *tea.Program) {
+	m.mu.Lock()
+	m.subs = append(m.subs, p)
+	var frame tanksnet.Frame
+	if len(m.lobby.Players) > 0 {
+		frame = m.buildFrame(nil)
+	}
+	m.mu.Unlock()
+
+	p.Send(frameMsg(frame))
+}
+
+// fire runs playerID's shot against the shared Game if it's actually their
+// turn, aiming at whichever player is next in turn order, then broadcasts
+// the resulting frame to every subscriber. It's a no-op for anyone whose
+// turn it isn't, including spectators (playerID -1 never matches a turn).
+func (m *match) fire(playerID int, angle, power float64) {
+	m.mu.Lock()
+	if !m.lobby.Ready() || m.lobby.CurrentTurn() != playerID {
+		m.mu.Unlock()
+		return
+	}
+
+	target := m.lobby.NextTurn()
+	if target == playerID {
+		m.mu.Unlock()
+		return
+	}
+
+	m.game.TankPos = m.lobby.Players[playerID].Pos
+	m.game.TargetPos = m.lobby.Players[target].Pos
+	m.game.Fire(angle, power)
+
+	var trajectory []tanksnet.Point
+	for m.game.Simulating {
+		m.game.Step(tanks.DefaultDt)
+		trajectory = append(trajectory, tanksnet.Point{X: m.game.BallPosX, Y: m.game.BallPosY})
+	}
+
+	shot := tanksnet.Shot{PlayerID: playerID, Angle: angle, Power: power, Trajectory: trajectory}
+	if m.game.Hit {
+		m.lobby.Players[target].Alive = false
+		shot.HitPlayer = target
+	}
+	m.lobby.AdvanceTurn()
+	m.seq++
+
+	frame := m.buildFrame(&shot)
+	subs := append([]*tea.Program(nil), m.subs...)
+	m.mu.Unlock()
+
+	for _, p := range subs {
+		p.Send(frameMsg(frame))
+	}
+}
+
+// buildFrame snapshots the match's current terrain and tank state into the
+// wire format every session's view renders from. Callers must hold m.mu.
+func (m *match) buildFrame(shot *tanksnet.Shot) tanksnet.Frame {
+	heights := make([]int, m.game.Terrain.Width())
+	for x := range heights {
+		heights[x] = m.game.Terrain.HeightAt(x)
+	}
+
+	tankStates := append([]tanksnet.TankState(nil), m.lobby.Players...)
+
+	turn := -1
+	if m.lobby.Ready() {
+		turn = m.lobby.CurrentTurn()
+	}
+
+	return tanksnet.Frame{
+		Seq:     m.seq,
+		Terrain: heights,
+		Tanks:   tankStates,
+		Shot:    shot,
+		Turn:    turn,
+	}
+}
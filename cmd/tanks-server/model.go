@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	tanksnet "github.com/Piyushjha03/terminalTanks/net"
+	"github.com/Piyushjha03/terminalTanks/pkg/tanks"
+)
+
+// frameMsg is a tea.Msg wrapping a broadcast tanksnet.Frame, sent to every
+// subscribed program whenever the match's state changes.
+type frameMsg tanksnet.Frame
+
+// matchModel is the per-session view onto a shared match: a thin renderer
+// over whatever frame the server last broadcast, plus the angle/power this
+// session is dialing in for its own next shot. playerID is -1 for a
+// spectator, who can watch but never fires.
+type matchModel struct {
+	m        *match
+	playerID int
+
+	frame tanksnet.Frame
+
+	angle, power float64
+}
+
+func newMatchModel(m *match, playerID int) matchModel {
+	return matchModel{m: m, playerID: playerID, angle: 45, power: 20}
+}
+
+func (mm matchModel) Init() tea.Cmd {
+	return nil
+}
+
+func (mm matchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "q" {
+			return mm, tea.Quit
+		}
+		if mm.playerID >= 0 && mm.isMyTurn() {
+			switch msg.String() {
+			case "a":
+				mm.angle -= 5
+			case "d":
+				mm.angle += 5
+			case "w":
+				mm.power++
+			case "s":
+				mm.power--
+			case "enter":
+				mm.m.fire(mm.playerID, mm.angle, mm.power)
+			}
+		}
+	case frameMsg:
+		mm.frame = tanksnet.Frame(msg)
+	}
+	return mm, nil
+}
+
+func (mm matchModel) isMyTurn() bool {
+	return mm.frame.Turn == mm.playerID
+}
+
+var (
+	matchTerrainStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("63"))
+	matchTankStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true)
+	matchTurnStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+	matchDeadStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+)
+
+func (mm matchModel) View() string {
+	if len(mm.frame.Tanks) == 0 {
+		return fmt.Sprintf("waiting for players to join room %q...\npress 'q' to quit\n", mm.m.name)
+	}
+
+	status := mm.statusLine()
+	return status + "\n" + mm.renderTerrain()
+}
+
+func (mm matchModel) statusLine() string {
+	switch {
+	case mm.playerID < 0:
+		return fmt.Sprintf("spectating room %q | angle/power hidden from spectators | 'q' to quit", mm.m.name)
+	case mm.isMyTurn():
+		return matchTurnStyle.Render(fmt.Sprintf("your turn | angle: %.1f | power: %.1f | a/d angle, w/s power, enter to fire", mm.angle, mm.power))
+	default:
+		return fmt.Sprintf("waiting on player %d | 'q' to quit", mm.frame.Turn)
+	}
+}
+
+func (mm matchModel) renderTerrain() string {
+	width := len(mm.frame.Terrain)
+	tankAt := make(map[int]int, len(mm.frame.Tanks))
+	for _, t := range mm.frame.Tanks {
+		if t.Alive {
+			tankAt[t.Pos] = t.PlayerID
+		}
+	}
+
+	view := ""
+	for y := tanks.TerrainRows - 1; y >= 0; y-- {
+		for x := 0; x < width; x++ {
+			switch {
+			case mm.frame.Terrain[x] == y && hasTank(tankAt, x):
+				view += matchTankStyle.Render(fmt.Sprintf("%d", tankAt[x]%10))
+			case mm.frame.Terrain[x] >= y:
+				view += matchTerrainStyle.Render("|")
+			default:
+				view += " "
+			}
+		}
+		view += "\n"
+	}
+
+	dead := ""
+	for _, t := range mm.frame.Tanks {
+		if !t.Alive {
+			dead += matchDeadStyle.Render(fmt.Sprintf(" [player %d eliminated]", t.PlayerID))
+		}
+	}
+	return view + dead
+}
+
+func hasTank(tankAt map[int]int, x int) bool {
+	_, ok := tankAt[x]
+	return ok
+}
@@ -1,96 +1,71 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"math"
-	"math/rand"
 	"os"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-)
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
 
-var gravity = 9.81
+	"github.com/Piyushjha03/terminalTanks/ai"
+	"github.com/Piyushjha03/terminalTanks/internal/rng"
+	"github.com/Piyushjha03/terminalTanks/pkg/tanks"
+)
 
+// model is a thin Bubble Tea adapter over a tanks.Game: it translates key
+// presses into Fire/Step calls and renders the Game's state, but owns none
+// of the terrain, physics, or hit-detection logic itself.
 type model struct {
-	terrain    []int
-	tankPos    int
-	targetPos  int
-	ballPosX   int
-	ballPosY   int
-	angle      float64
-	power      float64
-	simulating bool
-	hit        bool
-	timePassed float64
-}
-
-var terrainStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("63"))
-var tankStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true)
-var targetStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Bold(true)
-var ballStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
-var borderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("7")).Bold(true)
+	game *tanks.Game
 
-// generateTerrain generates a more realistic terrain using linear interpolation and superposition
-func generateTerrain(width int, iterations int) []int {
-	naiveTerrain := make([]float64, width)
-	for i := range naiveTerrain {
-		naiveTerrain[i] = float64(rand.Intn(10) + 5)
-	}
+	source    *rng.Source
+	roundSeed [4]uint64
+	roundNum  int
+	shotLog   []replayShot
 
-	var terrains [][]float64
-	weightSum := 0.0
-
-	for z := iterations; z > 0; z-- {
-		terrain := make([]float64, 0, width)
-		weight := 1 / math.Pow(2, float64(z-1))
-		sample := 1 << (iterations - z)
-
-		samplePoints := make([]float64, 0)
-		for i := 0; i < len(naiveTerrain); i += sample {
-			samplePoints = append(samplePoints, naiveTerrain[i])
-		}
+	aimer ai.Aimer
+}
 
-		weightSum += weight
+// newGameModel builds a fresh round using source for every random
+// placement, so the same seed always produces the same terrain, tank
+// position, and target. roundSeed captures source's state before that
+// draw, so writeRoundReplay can later reproduce this exact round. roundNum
+// starts at 1 so each round's replay is written to its own numbered file.
+func newGameModel(source *rng.Source) model {
+	roundSeed := source.State()
+	return model{game: tanks.NewGame(source), source: source, roundSeed: roundSeed, roundNum: 1}
+}
 
-		for i := 0; i < len(samplePoints); i++ {
-			terrain = append(terrain, weight*samplePoints[i])
-			for j := 1; j < sample; j++ {
-				mu := float64(j) / float64(sample)
-				a := samplePoints[i]
-				b := samplePoints[(i+1)%len(samplePoints)]
-				v := cosineInterpolation(a, b, mu)
-				terrain = append(terrain, weight*v)
-			}
-		}
-		terrains = append(terrains, terrain)
+// aiState snapshots the fields an ai.Aimer is allowed to see.
+func (m model) aiState() ai.State {
+	heights := make([]int, m.game.Terrain.Width())
+	for x := range heights {
+		heights[x] = m.game.Terrain.HeightAt(x)
 	}
-
-	finalTerrain := make([]float64, len(naiveTerrain))
-	for i := range finalTerrain {
-		for _, t := range terrains {
-			if i < len(t) {
-				finalTerrain[i] += t[i]
-			}
-		}
-		finalTerrain[i] /= weightSum
-	}
-
-	// Convert terrain heights to integers for simplicity
-	terrain := make([]int, len(finalTerrain))
-	for i := range finalTerrain {
-		terrain[i] = int(math.Round(finalTerrain[i]))
+	return ai.State{
+		Terrain:   heights,
+		TankPos:   m.game.TankPos,
+		TargetPos: m.game.TargetPos,
+		Wind:      m.game.Wind,
+		Drag:      m.game.Drag,
+		Gravity:   m.game.Gravity,
 	}
-	return terrain
 }
 
-func cosineInterpolation(a, b, mu float64) float64 {
-	mu2 := (1 - math.Cos(mu*math.Pi)) / 2
-	return a*(1-mu2) + b*mu2
-}
+var terrainStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("63"))
+var tankStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true)
+var targetStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Bold(true)
+var ballStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+var borderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("7")).Bold(true)
 
 func (m model) Init() tea.Cmd {
+	if m.game.Simulating {
+		return tick()
+	}
 	return nil
 }
 
@@ -100,53 +75,98 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.String() == "q" {
 			return m, tea.Quit
 		}
-		if !m.simulating {
+		if !m.game.Simulating {
 			switch msg.String() {
 			case "a":
-				m.angle -= 5
+				m.game.Angle -= 5
 			case "d":
-				m.angle += 5
+				m.game.Angle += 5
 			case "w":
-				m.power += 1
+				m.game.Power += 1
 			case "s":
-				m.power -= 1
+				m.game.Power -= 1
+			case "1":
+				m.game.Shell = tanks.ShellStandard
+			case "2":
+				m.game.Shell = tanks.ShellHeavy
+			case "3":
+				m.game.Shell = tanks.ShellDirtBomb
 			case "enter":
-				m.simulating = true
-				m.timePassed = 0
+				m.shotLog = append(m.shotLog, replayShot{Angle: m.game.Angle, Power: m.game.Power})
+				m.game.Fire(m.game.Angle, m.game.Power)
 				return m, tick()
 			}
 		}
 	case tickMsg:
-		return m.simulate()
+		m.game.Step(tanks.DefaultDt)
+		if m.game.Simulating {
+			return m, tick()
+		}
+		if m.game.Hit {
+			return m, nil
+		}
+		return m, reset()
 	case resetMsg:
-		return m.resetGame(), nil
+		m = m.writeRoundReplay()
+		m.shotLog = nil
+		m.roundSeed = m.source.State()
+		m.roundNum++
+		m.game = m.game.Reset()
+		if m.aimer != nil {
+			m = m.takeAITurn()
+			return m, tick()
+		}
+		return m, nil
 	}
 	return m, nil
 }
 
+// writeRoundReplay persists the round just played to disk before it's
+// discarded, returning m unchanged so it can be chained into Reset. Each
+// round gets its own numbered file so a multi-round session doesn't
+// overwrite earlier rounds' replays.
+func (m model) writeRoundReplay() model {
+	if len(m.shotLog) > 0 {
+		path := fmt.Sprintf("round-%d.tanksreplay", m.roundNum)
+		_ = writeReplay(path, replayLog{Seed: m.roundSeed, Shots: m.shotLog})
+	}
+	return m
+}
+
+// takeAITurn asks the model's aimer for an angle and power and fires
+// immediately, letting a CPU-controlled tank play without key input.
+func (m model) takeAITurn() model {
+	angle, power := m.aimer.Aim(m.aiState())
+	m.shotLog = append(m.shotLog, replayShot{Angle: angle, Power: power})
+	m.game.Fire(angle, power)
+	return m
+}
+
 func (m model) View() string {
-	view := borderStyle.Render(fmt.Sprintf("Angle: %.1f° | Power: %.1f | Press 'q' to quit", m.angle, m.power)) + "\n"
-	if m.hit {
+	g := m.game
+	view := borderStyle.Render(fmt.Sprintf("%s Wind: %+.1f | Angle: %.1f° | Power: %.1f | Shell: %s (1/2/3 to switch) | Press 'q' to quit",
+		windArrow(g.Wind), g.Wind, g.Angle, g.Power, g.Shell.Name())) + "\n"
+	if g.Hit {
 		view += "🎯 You hit the target! Press 'q' to quit.\n"
-	} else if !m.simulating && !m.hit {
+	} else if !g.Simulating && !g.Hit {
 		view += "❌ Missed! Game restarting...\n"
 	}
-	view += displayTerrainWithTank(m.terrain, m.tankPos, m.targetPos, m.ballPosX, m.ballPosY)
+	view += displayTerrainWithTank(g.Terrain, g.TankPos, g.TargetPos, g.BallPosX, g.BallPosY)
 	return view
 }
 
-func displayTerrainWithTank(terrain []int, tankPos, targetPos, ballPosX, ballPosY int) string {
+func displayTerrainWithTank(terrain *tanks.TerrainGrid, tankPos, targetPos, ballPosX, ballPosY int) string {
 	view := ""
-	for y := 30; y >= 0; y-- {
-		for x, h := range terrain {
+	for y := tanks.TerrainRows - 1; y >= 0; y-- {
+		for x := 0; x < terrain.Width(); x++ {
 			switch {
 			case x == ballPosX && y == ballPosY:
 				view += ballStyle.Render("O")
-			case x == tankPos && h == y:
+			case x == tankPos && terrain.HeightAt(x) == y:
 				view += tankStyle.Render("T")
-			case x == targetPos && h == y:
+			case x == targetPos && terrain.HeightAt(x) == y:
 				view += targetStyle.Render("X")
-			case h >= y:
+			case terrain.SolidAt(x, y):
 				view += terrainStyle.Render("|")
 			default:
 				view += " "
@@ -172,72 +192,138 @@ func reset() tea.Cmd {
 	})
 }
 
-func (m model) simulate() (tea.Model, tea.Cmd) {
-	if !m.simulating {
-		return m, nil
+// windArrow renders a HUD glyph pointing the way the wind is blowing.
+func windArrow(wind float64) string {
+	switch {
+	case wind > 0.2:
+		return "→"
+	case wind < -0.2:
+		return "←"
+	default:
+		return "·"
 	}
+}
 
-	angleRad := m.angle * math.Pi / 180
-	xPos := float64(m.tankPos)
-	yPos := float64(m.terrain[m.tankPos])
+func main() {
+	connect := flag.String("connect", "", "address of a tanks-server lobby to join instead of playing locally")
+	room := flag.String("room", "default", "which match room to join on the server (only used with -connect)")
+	replay := flag.String("replay", "", "replay a .tanksreplay file instead of playing")
+	seedFlag := flag.Uint64("seed", 0, "match seed; 0 picks a random seed")
+	aiFlag := flag.String("ai", "", "let a CPU tank play using the named aimer (binary|gradient)")
+	train := flag.String("train", "", "headlessly train the named aimer (binary|gradient) instead of playing")
+	flag.Parse()
+
+	if *connect != "" {
+		if err := runConnected(*connect, *room); err != nil {
+			fmt.Fprintf(os.Stderr, "Error connecting to %s: %v", *connect, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *replay != "" {
+		if err := runReplay(*replay); err != nil {
+			fmt.Fprintf(os.Stderr, "Error replaying %s: %v", *replay, err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	m.timePassed += 0.1
-	newXPos := xPos + m.power*math.Cos(angleRad)*m.timePassed
-	newYPos := yPos + m.power*math.Sin(angleRad)*m.timePassed - 0.5*gravity*m.timePassed*m.timePassed
+	seed := *seedFlag
+	if seed == 0 {
+		seed = uint64(time.Now().UnixNano())
+	}
 
-	if int(newXPos) >= len(m.terrain) || int(newXPos) < 0 || newYPos <= float64(m.terrain[int(newXPos)]) {
-		m.simulating = false
-		return m, reset()
+	if *train != "" {
+		aimer, err := newAimer(*train)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error training: %v", err)
+			os.Exit(1)
+		}
+		result := ai.RunTrainingHarness(aimer, 200, seed)
+		fmt.Printf("%s: avg %.2f shots to hit over %d rounds (%d unsolved)\n",
+			*train, result.AvgShotsToHit, result.Rounds, result.RoundsUnsolved)
+		return
 	}
 
-	m.ballPosX = int(math.Round(newXPos))
-	m.ballPosY = int(math.Round(newYPos))
+	initialModel := newGameModel(rng.New(seed))
 
-	if int(math.Abs(float64(m.targetPos-m.ballPosX))) <= 3 &&
-		m.ballPosY >= m.terrain[m.targetPos]-2 && m.ballPosY <= m.terrain[m.targetPos]+2 {
-		m.hit = true
-		m.simulating = false
-		return m, nil
+	if *aiFlag != "" {
+		aimer, err := newAimer(*aiFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error starting game: %v", err)
+			os.Exit(1)
+		}
+		initialModel.aimer = aimer
+		initialModel = initialModel.takeAITurn()
 	}
 
-	return m, tick()
+	p := tea.NewProgram(initialModel)
+	if err := p.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error starting game: %v", err)
+		os.Exit(1)
+	}
 }
 
-func (m model) resetGame() model {
-	return model{
-		terrain:    generateTerrain(100, 6),
-		tankPos:    rand.Intn(5) + 1,
-		targetPos:  rand.Intn(5) + 75,
-		ballPosX:   rand.Intn(5) + 1,
-		ballPosY:   m.terrain[rand.Intn(5)+1],
-		angle:      45,
-		power:      20,
-		simulating: false,
-		hit:        false,
+// newAimer resolves an -ai/-train flag value to an ai.Aimer implementation.
+func newAimer(name string) (ai.Aimer, error) {
+	switch name {
+	case "binary":
+		return ai.NewBinarySearchAimer(), nil
+	case "gradient":
+		return ai.NewGradientAimer(), nil
+	default:
+		return nil, fmt.Errorf("unknown aimer %q (want binary or gradient)", name)
 	}
 }
 
-func main() {
-	rand.Seed(time.Now().UnixNano())
-	terrain := generateTerrain(100, 6)
-	tankPos := rand.Intn(5) + 1
-	targetPos := rand.Intn(5) + 75
-
-	initialModel := model{
-		terrain:    terrain,
-		tankPos:    tankPos,
-		targetPos:  targetPos,
-		ballPosX:   tankPos,
-		ballPosY:   terrain[tankPos],
-		angle:      45,
-		power:      20,
-		simulating: false,
-		hit:        false,
+// runConnected joins a tanks-server lobby over SSH, the same transport the
+// server speaks, and attaches the local terminal to the remote session.
+// The server renders every player's and spectator's view itself and
+// broadcasts it over that session, so the client never needs to decode a
+// frame or run any simulation of its own; room picks which named lobby to
+// join, the way `ssh -l <room> host` would.
+func runConnected(addr, room string) error {
+	config := &ssh.ClientConfig{
+		User:            room,
+		Auth:            []ssh.AuthMethod{ssh.Password("")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
 	}
 
-	p := tea.NewProgram(initialModel)
-	if err := p.Start(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error starting game: %v", err)
-		os.Exit(1)
+	client, err := ssh.Dial("tcp", addr, config)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	fd := int(os.Stdin.Fd())
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		width, height = 80, 24
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, state)
+
+	if err := session.RequestPty("xterm-256color", height, width, ssh.TerminalModes{}); err != nil {
+		return err
+	}
+
+	session.Stdin = os.Stdin
+	session.Stdout = os.Stdout
+	session.Stderr = os.Stderr
+
+	if err := session.Shell(); err != nil {
+		return err
 	}
+	return session.Wait()
 }
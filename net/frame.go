@@ -0,0 +1,38 @@
+// Package net defines the wire format shared by the tanks server and its
+// connected clients. Every frame is plain JSON so that any client rendering
+// the same sequence of frames reaches an identical simulation state.
+package net
+
+// TankState describes a single player's tank as seen by every client in the
+// match.
+type TankState struct {
+	PlayerID int  `json:"player_id"`
+	Pos      int  `json:"pos"`
+	Alive    bool `json:"alive"`
+}
+
+// Shot describes one fired round, including the trajectory it traced so
+// clients can replay the arc instead of re-simulating it themselves.
+type Shot struct {
+	PlayerID   int     `json:"player_id"`
+	Angle      float64 `json:"angle"`
+	Power      float64 `json:"power"`
+	Trajectory []Point `json:"trajectory"`
+	HitPlayer  int     `json:"hit_player,omitempty"`
+}
+
+// Point is a single (x, y) sample along a shot's trajectory.
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Frame is the unit broadcast from server to clients. A client applies
+// frames in order and never needs to compute terrain or physics itself.
+type Frame struct {
+	Seq     int         `json:"seq"`
+	Terrain []int       `json:"terrain,omitempty"`
+	Tanks   []TankState `json:"tanks,omitempty"`
+	Shot    *Shot       `json:"shot,omitempty"`
+	Turn    int         `json:"turn"`
+}
@@ -0,0 +1,80 @@
+package net
+
+import "errors"
+
+// MinPlayers and MaxPlayers bound how many tanks can share a single match
+// room, not counting spectators.
+const (
+	MinPlayers = 2
+	MaxPlayers = 8
+)
+
+// ErrLobbyFull is returned when a player tries to join a room that already
+// has MaxPlayers tanks seated.
+var ErrLobbyFull = errors.New("net: lobby is full")
+
+// Lobby tracks the players and spectators waiting for, or currently playing,
+// a single match.
+type Lobby struct {
+	Name       string
+	Players    []TankState
+	Spectators int
+	turnOrder  []int
+	turnIdx    int
+}
+
+// NewLobby creates an empty, named match room.
+func NewLobby(name string) *Lobby {
+	return &Lobby{Name: name}
+}
+
+// Join seats a new player in the lobby, returning its assigned player ID.
+func (l *Lobby) Join(startPos int) (int, error) {
+	if len(l.Players) >= MaxPlayers {
+		return 0, ErrLobbyFull
+	}
+	id := len(l.Players)
+	l.Players = append(l.Players, TankState{PlayerID: id, Pos: startPos, Alive: true})
+	l.turnOrder = append(l.turnOrder, id)
+	return id, nil
+}
+
+// Spectate registers an onlooker who receives frames but never gets a turn.
+func (l *Lobby) Spectate() {
+	l.Spectators++
+}
+
+// Ready reports whether the lobby has enough seated players to start.
+func (l *Lobby) Ready() bool {
+	return len(l.Players) >= MinPlayers
+}
+
+// CurrentTurn returns the player ID allowed to act this turn.
+func (l *Lobby) CurrentTurn() int {
+	return l.turnOrder[l.turnIdx]
+}
+
+// NextTurn returns the player ID that will act after the current turn ends,
+// without advancing the turn itself. A duel-style match uses it to pick
+// who the current player's shot is aimed at. If every other player is
+// dead, it returns CurrentTurn, since there's no one left to aim at.
+func (l *Lobby) NextTurn() int {
+	idx := l.turnIdx
+	for i := 0; i < len(l.turnOrder); i++ {
+		idx = (idx + 1) % len(l.turnOrder)
+		if l.Players[l.turnOrder[idx]].Alive {
+			return l.turnOrder[idx]
+		}
+	}
+	return l.CurrentTurn()
+}
+
+// AdvanceTurn passes the turn token to the next living player.
+func (l *Lobby) AdvanceTurn() {
+	for i := 0; i < len(l.turnOrder); i++ {
+		l.turnIdx = (l.turnIdx + 1) % len(l.turnOrder)
+		if l.Players[l.turnOrder[l.turnIdx]].Alive {
+			break
+		}
+	}
+}
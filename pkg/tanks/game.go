@@ -0,0 +1,183 @@
+package tanks
+
+import (
+	"math"
+
+	"github.com/Piyushjha03/terminalTanks/internal/rng"
+)
+
+// World and physics defaults. Exported so a UI or trainer that wants to
+// build a non-standard Game can still start from the normal shape.
+const (
+	TerrainWidth      = 100
+	TerrainRows       = 31
+	TerrainIterations = 6
+
+	DefaultGravity = 9.81
+	DefaultDrag    = 0.004
+
+	// DefaultDt is the fixed tick the original Bubble Tea model advanced
+	// the simulation by.
+	DefaultDt = 0.1
+)
+
+// Game is the headless artillery simulation: terrain, tank and target
+// placement, and the shell currently in flight. It has no notion of a
+// terminal, a key press, or a frame rate — callers drive it with Fire and
+// Step.
+type Game struct {
+	Terrain    *TerrainGrid
+	TankPos    int
+	TargetPos  int
+	BallPosX   int
+	BallPosY   int
+	Angle      float64
+	Power      float64
+	Shell      ShellType
+	Simulating bool
+	Hit        bool
+	TimePassed float64
+
+	Wind    float64
+	Drag    float64
+	Gravity float64
+
+	ballX, ballY float64
+	velX, velY   float64
+
+	muzzleX, muzzleY int
+	clearedMuzzle    bool
+
+	source *rng.Source
+}
+
+// NewGame builds a fresh round: terrain, tank and target placement, and a
+// random wind, all derived from source so the same seed always reproduces
+// the same round.
+func NewGame(source *rng.Source) *Game {
+	terrain := NewTerrainGrid(generateHeights(TerrainWidth, TerrainIterations, source), TerrainRows)
+	tankPos := source.Intn(5) + 1
+	return &Game{
+		Terrain:   terrain,
+		TankPos:   tankPos,
+		TargetPos: source.Intn(5) + 75,
+		BallPosX:  tankPos,
+		BallPosY:  terrain.HeightAt(tankPos),
+		Angle:     45,
+		Power:     20,
+		Shell:     ShellStandard,
+		Wind:      (source.Float64()*2 - 1) * 3,
+		Drag:      DefaultDrag,
+		Gravity:   DefaultGravity,
+		source:    source,
+	}
+}
+
+// Fire launches a shell from the tank at the given angle and power,
+// resetting the ball's position and velocity to the tank's muzzle.
+func (g *Game) Fire(angle, power float64) {
+	g.Angle = angle
+	g.Power = power
+
+	angleRad := angle * math.Pi / 180
+	g.ballX = float64(g.TankPos)
+	g.ballY = float64(g.Terrain.HeightAt(g.TankPos))
+	g.velX = power * math.Cos(angleRad)
+	g.velY = power * math.Sin(angleRad)
+
+	g.muzzleX, g.muzzleY = int(math.Round(g.ballX)), int(math.Round(g.ballY))
+	g.clearedMuzzle = false
+
+	g.Simulating = true
+	g.Hit = false
+	g.TimePassed = 0
+}
+
+// Step advances the shell in flight by dt seconds using semi-implicit
+// Euler: velocity is updated from gravity, wind, and quadratic drag first,
+// then that new velocity moves the position. When the shell is moving fast
+// enough to cross more than one cell this step, it's broken into smaller
+// sub-steps so it can't tunnel through a thin terrain column without ever
+// landing on a cell that would have stopped it. Step is a no-op once the
+// shell has landed; callers check Simulating to know when to stop calling
+// it.
+func (g *Game) Step(dt float64) {
+	if !g.Simulating {
+		return
+	}
+
+	g.TimePassed += dt
+
+	steps := 1
+	if speed := math.Hypot(g.velX, g.velY); speed*dt > 1 {
+		steps = int(math.Ceil(speed * dt))
+	}
+	subDt := dt / float64(steps)
+
+	for i := 0; i < steps; i++ {
+		speed := math.Hypot(g.velX, g.velY)
+		dragAccX := -g.Drag * speed * g.velX
+		dragAccY := -g.Drag * speed * g.velY
+		g.velX += (g.Wind + dragAccX) * subDt
+		g.velY += (-g.Gravity + dragAccY) * subDt
+		g.ballX += g.velX * subDt
+		g.ballY += g.velY * subDt
+
+		x, y := int(math.Round(g.ballX)), int(math.Round(g.ballY))
+
+		// The shell spawns resting on the tank's own muzzle cell, which is
+		// itself solid ground. Until it has moved off that cell at least
+		// once, don't let it collide with it.
+		if !g.clearedMuzzle {
+			if x == g.muzzleX && y == g.muzzleY {
+				g.BallPosX, g.BallPosY = x, y
+				continue
+			}
+			g.clearedMuzzle = true
+		}
+
+		if x >= g.Terrain.Width() || x < 0 || g.Terrain.SolidAt(x, y) {
+			hit := g.withinSplash(x, y)
+			g.Shell.Apply(g.Terrain, x, y)
+			g.Terrain.Collapse()
+			g.Simulating = false
+			g.Hit = hit
+			return
+		}
+
+		g.BallPosX, g.BallPosY = x, y
+
+		if g.withinSplash(x, y) {
+			g.Shell.Apply(g.Terrain, x, y)
+			g.Terrain.Collapse()
+			g.Hit = true
+			g.Simulating = false
+			return
+		}
+	}
+}
+
+// withinSplash reports whether an explosion centered at (x, y) reaches the
+// target even without a direct column/row match.
+func (g *Game) withinSplash(x, y int) bool {
+	dx := x - g.TargetPos
+	dy := y - g.Terrain.HeightAt(g.TargetPos)
+	radius := g.Shell.SplashRadius()
+	return dx*dx+dy*dy <= radius*radius
+}
+
+// Reset starts a new round using the same seeded source, so a sequence of
+// rounds played from one Game remains reproducible end to end.
+func (g *Game) Reset() *Game {
+	return NewGame(g.source)
+}
+
+// Clone returns a copy of the Game with its own copy of the terrain, so a
+// trial shot (AI aim search, replay scrubbing) can run Fire and Step against
+// the copy without ever mutating the real match in progress. The copy shares
+// the original's rng source, so calling Reset on it draws from that stream.
+func (g *Game) Clone() *Game {
+	clone := *g
+	clone.Terrain = g.Terrain.Clone()
+	return &clone
+}
@@ -0,0 +1,150 @@
+package tanks
+
+import "testing"
+
+// flatGame builds a Game on mostly-empty terrain with a small plateau under
+// the tank, so tests can reason about a shell's flight without terrain
+// generation noise.
+func flatGame(width, tankX, plateauHeight int) *Game {
+	heights := make([]int, width)
+	heights[tankX] = plateauHeight
+	terrain := NewTerrainGrid(heights, TerrainRows)
+	return &Game{
+		Terrain:   terrain,
+		TankPos:   tankX,
+		TargetPos: width - 1,
+		BallPosX:  tankX,
+		BallPosY:  terrain.HeightAt(tankX),
+		Shell:     ShellStandard,
+	}
+}
+
+func runToLanding(t *testing.T, g *Game, maxSteps int) {
+	t.Helper()
+	for i := 0; i < maxSteps && g.Simulating; i++ {
+		g.Step(DefaultDt)
+	}
+	if g.Simulating {
+		t.Fatalf("shell still in flight after %d steps", maxSteps)
+	}
+}
+
+func TestTrajectoryArcsForward(t *testing.T) {
+	cases := []struct {
+		name     string
+		angle    float64
+		power    float64
+		minLandX int
+		maxLandX int
+	}{
+		{"low arc", 30, 12, 3, 25},
+		{"45 degree arc", 45, 14, 3, 35},
+		{"steep arc", 70, 16, 1, 20},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := flatGame(100, 2, 5)
+			g.Gravity = DefaultGravity
+			g.Fire(c.angle, c.power)
+			runToLanding(t, g, 2000)
+
+			if g.BallPosX <= g.TankPos {
+				t.Fatalf("expected shell to land ahead of the tank, landed at x=%d (tank at x=%d)", g.BallPosX, g.TankPos)
+			}
+			if g.BallPosX < c.minLandX || g.BallPosX > c.maxLandX {
+				t.Fatalf("landed at x=%d, want between %d and %d", g.BallPosX, c.minLandX, c.maxLandX)
+			}
+		})
+	}
+}
+
+func TestStepStopsAtTerrainBoundaries(t *testing.T) {
+	cases := []struct {
+		name  string
+		angle float64
+		tank  int
+		width int
+	}{
+		{"left edge", 180, 1, 20},
+		{"right edge", 0, 17, 20},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := flatGame(c.width, c.tank, 5)
+			g.Gravity = 0
+			g.Fire(c.angle, 10)
+
+			runToLanding(t, g, 2000)
+
+			if g.Simulating {
+				t.Fatalf("expected shell to stop at the terrain boundary")
+			}
+		})
+	}
+}
+
+// wallGame puts the tank at x=2 and a solid wall at x=10, both at the same
+// height, so a zero-gravity shot fired horizontally flies along that row
+// toward the wall. The target also stands at that height, so splash
+// distance comes down to the horizontal gap between it and the shell: the
+// shell bursts as soon as it passes within splash radius of the target, and
+// only reaches the wall when the target is out of range.
+func wallGame(targetPos int) *Game {
+	heights := make([]int, 20)
+	heights[2] = 5
+	heights[10] = 5
+	heights[targetPos] = 5
+	terrain := NewTerrainGrid(heights, TerrainRows)
+	return &Game{
+		Terrain:   terrain,
+		TankPos:   2,
+		TargetPos: targetPos,
+		BallPosX:  2,
+		BallPosY:  terrain.HeightAt(2),
+		Shell:     ShellStandard,
+	}
+}
+
+func TestSplashHitBox(t *testing.T) {
+	cases := []struct {
+		name      string
+		targetPos int
+		wantHit   bool
+	}{
+		{"target standing on the wall", 10, true},
+		{"within splash radius", 8, true},
+		{"outside splash radius", 16, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := wallGame(c.targetPos)
+			g.Gravity = 0
+			g.Fire(0, 10) // straight toward the wall at constant height
+
+			runToLanding(t, g, 2000)
+
+			if g.Hit != c.wantHit {
+				t.Fatalf("Hit = %v, want %v (landed at x=%d y=%d)", g.Hit, c.wantHit, g.BallPosX, g.BallPosY)
+			}
+		})
+	}
+}
+
+func TestCloneDoesNotMutateOriginal(t *testing.T) {
+	g := flatGame(100, 2, 5)
+	clone := g.Clone()
+
+	clone.Gravity = DefaultGravity
+	clone.Fire(45, 20)
+	runToLanding(t, clone, 2000)
+
+	if g.Simulating {
+		t.Fatalf("original game should be untouched by firing the clone")
+	}
+	if g.Terrain.HeightAt(2) != 5 {
+		t.Fatalf("original terrain was mutated by the clone's shot")
+	}
+}
@@ -0,0 +1,53 @@
+package tanks
+
+// ShellType selects how a fired round affects the terrain on impact.
+type ShellType int
+
+const (
+	ShellStandard ShellType = iota
+	ShellHeavy
+	ShellDirtBomb
+)
+
+// shellProfile describes a shell's blast radius and, for shells that carve
+// terrain, how much damage it does per cell.
+type shellProfile struct {
+	radius   int
+	damage   int
+	addsDirt bool
+}
+
+var shellProfiles = map[ShellType]shellProfile{
+	ShellStandard: {radius: 3, damage: DirtHP},
+	ShellHeavy:    {radius: 6, damage: DirtHP * 2},
+	ShellDirtBomb: {radius: 4, addsDirt: true},
+}
+
+// Apply carves or fills terrain around (x, y) according to the shell's
+// profile.
+func (s ShellType) Apply(terrain *TerrainGrid, x, y int) {
+	profile := shellProfiles[s]
+	if profile.addsDirt {
+		terrain.Fill(x, y, profile.radius)
+		return
+	}
+	terrain.Carve(x, y, profile.radius, profile.damage)
+}
+
+// SplashRadius returns how far from an impact point the blast still damages
+// a tank, even without hitting its column directly.
+func (s ShellType) SplashRadius() int {
+	return shellProfiles[s].radius
+}
+
+// Name returns the HUD label for the shell type.
+func (s ShellType) Name() string {
+	switch s {
+	case ShellHeavy:
+		return "heavy"
+	case ShellDirtBomb:
+		return "dirt-bomb"
+	default:
+		return "standard"
+	}
+}
@@ -0,0 +1,205 @@
+// Package tanks holds the headless artillery simulation: terrain
+// generation, projectile physics, and hit detection, with no dependency on
+// bubbletea or lipgloss. A UI, a replay player, an AI trainer, or a
+// networked server can all drive a Game without touching a terminal.
+package tanks
+
+import (
+	"math"
+
+	"github.com/Piyushjha03/terminalTanks/internal/rng"
+)
+
+// DirtHP is the number of hit points a freshly generated terrain cell
+// starts with. A cell stops being solid once its hit points reach zero.
+const DirtHP = 3
+
+// Cell is a single column/row slot in the terrain grid. A cell with hp <= 0
+// is air; any other cell is solid ground a shell or tank can rest on.
+type Cell struct {
+	hp int
+}
+
+func (c Cell) solid() bool {
+	return c.hp > 0
+}
+
+// TerrainGrid is the destructible occupancy grid a Game plays on.
+// cells[x][y] holds the cell at column x, row y, with row 0 at the bottom
+// of the world.
+type TerrainGrid struct {
+	cells [][]Cell
+	rows  int
+}
+
+// NewTerrainGrid builds a grid of the given row count from a per-column
+// height slice, the shape terrain generation has always produced.
+func NewTerrainGrid(heights []int, rows int) *TerrainGrid {
+	g := &TerrainGrid{
+		cells: make([][]Cell, len(heights)),
+		rows:  rows,
+	}
+	for x, h := range heights {
+		g.cells[x] = make([]Cell, rows)
+		for y := 0; y <= h && y < rows; y++ {
+			g.cells[x][y] = Cell{hp: DirtHP}
+		}
+	}
+	return g
+}
+
+// Width returns the number of columns in the grid.
+func (g *TerrainGrid) Width() int {
+	return len(g.cells)
+}
+
+// SolidAt reports whether the cell at (x, y) is solid ground. Out-of-bounds
+// coordinates are never solid.
+func (g *TerrainGrid) SolidAt(x, y int) bool {
+	if x < 0 || x >= g.Width() || y < 0 || y >= g.rows {
+		return false
+	}
+	return g.cells[x][y].solid()
+}
+
+// HeightAt returns the row of the topmost solid cell in column x, or -1 if
+// the column is entirely air.
+func (g *TerrainGrid) HeightAt(x int) int {
+	if x < 0 || x >= g.Width() {
+		return -1
+	}
+	for y := g.rows - 1; y >= 0; y-- {
+		if g.cells[x][y].solid() {
+			return y
+		}
+	}
+	return -1
+}
+
+// Carve removes hit points from every cell within radius of (cx, cy),
+// carving a circular crater. A cell that reaches zero hit points becomes
+// air.
+func (g *TerrainGrid) Carve(cx, cy, radius, damage int) {
+	for x := cx - radius; x <= cx+radius; x++ {
+		if x < 0 || x >= g.Width() {
+			continue
+		}
+		for y := cy - radius; y <= cy+radius; y++ {
+			if y < 0 || y >= g.rows {
+				continue
+			}
+			dx, dy := x-cx, y-cy
+			if dx*dx+dy*dy > radius*radius {
+				continue
+			}
+			g.cells[x][y].hp -= damage
+			if g.cells[x][y].hp < 0 {
+				g.cells[x][y].hp = 0
+			}
+		}
+	}
+}
+
+// Fill adds terrain within radius of (cx, cy), the dirt-bomb's effect.
+func (g *TerrainGrid) Fill(cx, cy, radius int) {
+	for x := cx - radius; x <= cx+radius; x++ {
+		if x < 0 || x >= g.Width() {
+			continue
+		}
+		for y := cy - radius; y <= cy+radius; y++ {
+			if y < 0 || y >= g.rows {
+				continue
+			}
+			dx, dy := x-cx, y-cy
+			if dx*dx+dy*dy > radius*radius {
+				continue
+			}
+			g.cells[x][y].hp = DirtHP
+		}
+	}
+}
+
+// Collapse lets unsupported ground fall one row per call. Carving a crater
+// can leave a solid cell floating over air; each call lowers every such
+// cell by one row, column by column. Game.Step calls it once per impact,
+// not every tick, so an overhang more than one row above the ground is
+// still floating after the shot that carved it.
+func (g *TerrainGrid) Collapse() {
+	for x := 0; x < g.Width(); x++ {
+		col := g.cells[x]
+		for y := 1; y < len(col); y++ {
+			if col[y].solid() && !col[y-1].solid() {
+				col[y-1] = col[y]
+				col[y] = Cell{}
+			}
+		}
+	}
+}
+
+// Clone returns a deep copy of the grid, so trial simulations (AI aim
+// search, replay scrubbing) can mutate it without affecting the original.
+func (g *TerrainGrid) Clone() *TerrainGrid {
+	clone := &TerrainGrid{cells: make([][]Cell, len(g.cells)), rows: g.rows}
+	for x, col := range g.cells {
+		clone.cells[x] = append([]Cell(nil), col...)
+	}
+	return clone
+}
+
+// generateHeights produces a more realistic terrain using cosine
+// interpolation and superposition.
+func generateHeights(width int, iterations int, source *rng.Source) []int {
+	naiveTerrain := make([]float64, width)
+	for i := range naiveTerrain {
+		naiveTerrain[i] = float64(source.Intn(10) + 5)
+	}
+
+	var terrains [][]float64
+	weightSum := 0.0
+
+	for z := iterations; z > 0; z-- {
+		terrain := make([]float64, 0, width)
+		weight := 1 / math.Pow(2, float64(z-1))
+		sample := 1 << (iterations - z)
+
+		samplePoints := make([]float64, 0)
+		for i := 0; i < len(naiveTerrain); i += sample {
+			samplePoints = append(samplePoints, naiveTerrain[i])
+		}
+
+		weightSum += weight
+
+		for i := 0; i < len(samplePoints); i++ {
+			terrain = append(terrain, weight*samplePoints[i])
+			for j := 1; j < sample; j++ {
+				mu := float64(j) / float64(sample)
+				a := samplePoints[i]
+				b := samplePoints[(i+1)%len(samplePoints)]
+				v := cosineInterpolation(a, b, mu)
+				terrain = append(terrain, weight*v)
+			}
+		}
+		terrains = append(terrains, terrain)
+	}
+
+	finalTerrain := make([]float64, len(naiveTerrain))
+	for i := range finalTerrain {
+		for _, t := range terrains {
+			if i < len(t) {
+				finalTerrain[i] += t[i]
+			}
+		}
+		finalTerrain[i] /= weightSum
+	}
+
+	heights := make([]int, len(finalTerrain))
+	for i := range finalTerrain {
+		heights[i] = int(math.Round(finalTerrain[i]))
+	}
+	return heights
+}
+
+func cosineInterpolation(a, b, mu float64) float64 {
+	mu2 := (1 - math.Cos(mu*math.Pi)) / 2
+	return a*(1-mu2) + b*mu2
+}
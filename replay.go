@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Piyushjha03/terminalTanks/internal/rng"
+	"github.com/Piyushjha03/terminalTanks/pkg/tanks"
+)
+
+// replayShot records one fired round so a .tanksreplay file can reconstruct
+// the exact sequence of shots played in a round.
+type replayShot struct {
+	Angle float64 `json:"angle"`
+	Power float64 `json:"power"`
+}
+
+// replayLog is the on-disk format written to a .tanksreplay file: the RNG
+// state the round's terrain and tank/target placement were generated from,
+// plus every shot fired in that round, in order.
+type replayLog struct {
+	Seed  [4]uint64    `json:"seed"`
+	Shots []replayShot `json:"shots"`
+}
+
+// writeReplay serializes a replayLog to path as JSON.
+func writeReplay(path string, log replayLog) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	defer w.Flush()
+	return json.NewEncoder(w).Encode(log)
+}
+
+// readReplay loads a .tanksreplay file written by writeReplay.
+func readReplay(path string) (replayLog, error) {
+	var log replayLog
+	f, err := os.Open(path)
+	if err != nil {
+		return log, err
+	}
+	defer f.Close()
+
+	err = json.NewDecoder(bufio.NewReader(f)).Decode(&log)
+	return log, err
+}
+
+// runReplay reconstructs the terrain and tank/target placement from the
+// recorded seed, then re-drives the Game's Fire and Step with each logged
+// shot in turn so the round plays out bit-exactly as it did originally.
+func runReplay(path string) error {
+	log, err := readReplay(path)
+	if err != nil {
+		return err
+	}
+
+	source := rng.FromState(log.Seed)
+	m := newGameModel(source)
+
+	for _, shot := range log.Shots {
+		m.game.Fire(shot.Angle, shot.Power)
+
+		for m.game.Simulating {
+			m.game.Step(tanks.DefaultDt)
+		}
+		printReplayFrame(m)
+	}
+	return nil
+}
+
+// printReplayFrame renders a single frame of a replayed round to stdout.
+func printReplayFrame(m model) {
+	fmt.Print(m.View())
+}